@@ -0,0 +1,27 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package gallery
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+// verifySHA256 returns an error if the SHA-256 digest of b does not match
+// the lowercase hex-encoded want.
+func verifySHA256(b []byte, want string) error {
+	sum := sha256.Sum256(b)
+	got := hex.EncodeToString(sum[:])
+	if got != want {
+		return fmt.Errorf("sha256 mismatch: want %s, got %s", want, got)
+	}
+	return nil
+}
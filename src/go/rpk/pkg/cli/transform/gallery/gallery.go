@@ -0,0 +1,148 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+// Package gallery implements discovery and installation of prebuilt,
+// SHA-256 verified Wasm transforms published to a gallery index.
+package gallery
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/redpanda-data/redpanda/src/go/rpk/pkg/cli/transform/project"
+	"github.com/spf13/afero"
+	"gopkg.in/yaml.v3"
+)
+
+// File describes a single artifact belonging to a gallery Transform, most
+// commonly the compiled `.wasm` binary.
+type File struct {
+	Filename string `yaml:"filename"`
+	SHA256   string `yaml:"sha256"`
+	URI      string `yaml:"uri"`
+}
+
+// Transform is one installable entry in a gallery Config.
+type Transform struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description,omitempty"`
+	License     string `yaml:"license,omitempty"`
+	// Language records the WasmLang the prebuilt artifact was compiled
+	// from, so Install can write a valid transform.yaml without needing
+	// that toolchain installed locally.
+	Language project.WasmLang `yaml:"language"`
+	URLs     []string         `yaml:"urls,omitempty"`
+	Files    []File           `yaml:"files"`
+}
+
+// Config is a gallery index: a catalog of prebuilt transforms that can be
+// installed by name via `rpk transform install <gallery>/<name>`.
+type Config struct {
+	Name       string      `yaml:"name"`
+	Transforms []Transform `yaml:"transforms"`
+}
+
+// Find returns the Transform named name, or an error listing what is
+// available if it isn't in the gallery.
+func (c Config) Find(name string) (Transform, error) {
+	for _, t := range c.Transforms {
+		if t.Name == name {
+			return t, nil
+		}
+	}
+	available := make([]string, len(c.Transforms))
+	for i, t := range c.Transforms {
+		available[i] = t.Name
+	}
+	return Transform{}, fmt.Errorf("no transform named %q in gallery %q (available: %s)", name, c.Name, strings.Join(available, ", "))
+}
+
+// Load reads a gallery Config from source, which may be an https:// URL or a
+// path on fs.
+func Load(fs afero.Fs, source string) (Config, error) {
+	var c Config
+	b, err := read(fs, source)
+	if err != nil {
+		return c, fmt.Errorf("loading gallery %q: %w", source, err)
+	}
+	if err := yaml.Unmarshal(b, &c); err != nil {
+		return c, fmt.Errorf("parsing gallery %q: %w", source, err)
+	}
+	return c, nil
+}
+
+// read fetches the raw bytes at source, over HTTPS if it is remote (see
+// isRemote) or from the local filesystem otherwise.
+func read(fs afero.Fs, source string) ([]byte, error) {
+	if isRemote(source) {
+		resp, err := http.Get(source)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("unexpected status %s", resp.Status)
+		}
+		return io.ReadAll(resp.Body)
+	}
+	return afero.ReadFile(fs, source)
+}
+
+// isRemote reports whether source is a remote HTTPS URL rather than a local
+// path. Only https:// is accepted, mirroring template.isRemote: plain
+// http:// is unauthenticated.
+func isRemote(source string) bool {
+	return strings.HasPrefix(source, "https://")
+}
+
+// fetchFile downloads file's artifact into destDir, verifying its SHA-256
+// checksum against the gallery manifest, and returns the path it was
+// written to. galleryRemote must be true if the gallery index itself was
+// loaded from a remote source: in that case file.URI must also be https://,
+// since otherwise an attacker-hosted manifest could point uri at an
+// arbitrary local path and have read fall back to reading it straight off
+// the installing user's filesystem.
+func fetchFile(fs afero.Fs, file File, destDir string, galleryRemote bool) (string, error) {
+	if galleryRemote && !isRemote(file.URI) {
+		return "", fmt.Errorf("fetching %q: uri %q must be an https:// URL when the gallery is remote", file.Filename, file.URI)
+	}
+	b, err := read(fs, file.URI)
+	if err != nil {
+		return "", fmt.Errorf("fetching %q: %w", file.Filename, err)
+	}
+	if err := verifySHA256(b, file.SHA256); err != nil {
+		return "", fmt.Errorf("%q failed verification: %w", file.Filename, err)
+	}
+	name, err := sanitizeFilename(file.Filename)
+	if err != nil {
+		return "", fmt.Errorf("fetching: %w", err)
+	}
+	dst := filepath.Join(destDir, name)
+	if err := afero.WriteFile(fs, dst, b, 0o644); err != nil {
+		return "", err
+	}
+	return dst, nil
+}
+
+// sanitizeFilename returns name if it is a single path component, or an
+// error otherwise. name comes straight from the (potentially
+// attacker-hosted) gallery manifest, so it must be rejected rather than
+// joined onto destDir as-is: a manifest entry like
+// filename: "../../../../.ssh/authorized_keys" would otherwise let
+// `rpk transform install` write arbitrary content outside destDir.
+func sanitizeFilename(name string) (string, error) {
+	base := filepath.Base(name)
+	if base != name || base == ".." || base == "." {
+		return "", fmt.Errorf("filename %q must be a single path component", name)
+	}
+	return base, nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package gallery
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/spf13/afero"
+)
+
+func TestSanitizeFilenameRejectsPathTraversal(t *testing.T) {
+	cases := []string{
+		"../../../../etc/evil",
+		"/etc/evil",
+		"..",
+		"sub/dir/evil",
+	}
+	for _, name := range cases {
+		if _, err := sanitizeFilename(name); err == nil {
+			t.Errorf("sanitizeFilename(%q) = nil error, want an error", name)
+		}
+	}
+}
+
+func TestSanitizeFilenameAllowsSingleComponent(t *testing.T) {
+	got, err := sanitizeFilename("out.wasm")
+	if err != nil {
+		t.Fatalf("sanitizeFilename: %v", err)
+	}
+	if got != "out.wasm" {
+		t.Errorf("sanitizeFilename(%q) = %q, want unchanged", "out.wasm", got)
+	}
+}
+
+func TestFetchFileRejectsLocalArtifactURIFromRemoteGallery(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	content := []byte("local secret")
+	afero.WriteFile(fs, "/etc/secret", content, 0o644)
+	sum := sha256.Sum256(content)
+	file := File{
+		Filename: "out.wasm",
+		SHA256:   hex.EncodeToString(sum[:]),
+		URI:      "/etc/secret",
+	}
+	if _, err := fetchFile(fs, file, "/project", true); err == nil {
+		t.Fatal("expected an error for a non-https:// artifact uri from a remote gallery, got nil")
+	}
+	if exists, _ := afero.Exists(fs, "/project/out.wasm"); exists {
+		t.Error("fetchFile wrote a file despite rejecting the artifact uri")
+	}
+}
+
+func TestFetchFileAllowsLocalArtifactURIFromLocalGallery(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	content := []byte("local artifact")
+	afero.WriteFile(fs, "/build/out.wasm", content, 0o644)
+	sum := sha256.Sum256(content)
+	file := File{
+		Filename: "out.wasm",
+		SHA256:   hex.EncodeToString(sum[:]),
+		URI:      "/build/out.wasm",
+	}
+	if _, err := fetchFile(fs, file, "/project", false); err != nil {
+		t.Fatalf("fetchFile: %v", err)
+	}
+	if exists, _ := afero.Exists(fs, "/project/out.wasm"); !exists {
+		t.Error("expected /project/out.wasm to have been written")
+	}
+}
+
+func TestIsRemote(t *testing.T) {
+	cases := map[string]bool{
+		"https://example.com/transforms/out.wasm": true,
+		"http://example.com/transforms/out.wasm":  false,
+		"/local/out.wasm":                         false,
+		"./out.wasm":                              false,
+	}
+	for source, want := range cases {
+		if got := isRemote(source); got != want {
+			t.Errorf("isRemote(%q) = %v, want %v", source, got, want)
+		}
+	}
+}
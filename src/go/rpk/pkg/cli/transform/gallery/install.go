@@ -0,0 +1,91 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package gallery
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/redpanda-data/redpanda/src/go/rpk/pkg/cli/transform/project"
+	"github.com/spf13/afero"
+)
+
+// InstallOptions controls how Install materializes a gallery Transform into
+// a local project directory.
+type InstallOptions struct {
+	// InputTopic, if set, overrides the default input-topic placeholder.
+	InputTopic string
+	// OutputTopic, if set, overrides the default output-topic placeholder.
+	OutputTopic string
+}
+
+// ParseRef splits a "<gallery>/<name>" reference as accepted by
+// `rpk transform install` into its gallery source and transform name.
+func ParseRef(ref string) (source, name string, err error) {
+	i := strings.LastIndex(ref, "/")
+	if i < 0 {
+		return "", "", fmt.Errorf("invalid gallery reference %q, expected <gallery>/<name>", ref)
+	}
+	return ref[:i], ref[i+1:], nil
+}
+
+// Install fetches the named transform from the gallery loaded from source,
+// verifies every file's checksum, and writes the artifacts plus a
+// transform.yaml into dir.
+func Install(fs afero.Fs, source, name, dir string, opts InstallOptions) (project.Config, error) {
+	gc, err := Load(fs, source)
+	if err != nil {
+		return project.Config{}, err
+	}
+	t, err := gc.Find(name)
+	if err != nil {
+		return project.Config{}, err
+	}
+	if len(t.Files) == 0 {
+		return project.Config{}, fmt.Errorf("transform %q has no files to install", name)
+	}
+	if t.Language == "" {
+		return project.Config{}, fmt.Errorf("transform %q in gallery %q does not declare a language", name, gc.Name)
+	}
+	if err := fs.MkdirAll(dir, 0o755); err != nil {
+		return project.Config{}, err
+	}
+	galleryRemote := isRemote(source)
+	for _, f := range t.Files {
+		if _, err := fetchFile(fs, f, dir, galleryRemote); err != nil {
+			return project.Config{}, err
+		}
+	}
+
+	inputTopic := opts.InputTopic
+	if inputTopic == "" {
+		inputTopic = "input"
+	}
+	outputTopic := opts.OutputTopic
+	if outputTopic == "" {
+		outputTopic = "output"
+	}
+	cfg := project.Config{
+		Name:        t.Name,
+		Description: t.Description,
+		InputTopic:  inputTopic,
+		OutputTopic: outputTopic,
+		Language:    t.Language,
+	}
+	b, err := project.MarshalConfig(cfg)
+	if err != nil {
+		return project.Config{}, err
+	}
+	if err := afero.WriteFile(fs, filepath.Join(dir, project.ConfigFileName), b, 0o644); err != nil {
+		return project.Config{}, err
+	}
+	return cfg, nil
+}
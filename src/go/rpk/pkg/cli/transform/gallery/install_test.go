@@ -0,0 +1,95 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package gallery
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/redpanda-data/redpanda/src/go/rpk/pkg/cli/transform/project"
+	"github.com/spf13/afero"
+)
+
+func writeTestGallery(t *testing.T, fs afero.Fs, wasm []byte) {
+	t.Helper()
+	sum := sha256.Sum256(wasm)
+	if err := afero.WriteFile(fs, "/mod.wasm", wasm, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	index := fmt.Sprintf(`
+name: test-gallery
+transforms:
+  - name: uppercase
+    description: uppercases values
+    language: tinygo
+    files:
+      - filename: uppercase.wasm
+        sha256: %s
+        uri: /mod.wasm
+`, hex.EncodeToString(sum[:]))
+	if err := afero.WriteFile(fs, "/gallery.yaml", []byte(index), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestInstall(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	writeTestGallery(t, fs, []byte("fake-wasm-bytes"))
+
+	cfg, err := Install(fs, "/gallery.yaml", "uppercase", "/proj", InstallOptions{})
+	if err != nil {
+		t.Fatalf("Install: %v", err)
+	}
+	if cfg.Language != project.WasmLangTinygo {
+		t.Errorf("Language = %q, want %q", cfg.Language, project.WasmLangTinygo)
+	}
+
+	ok, err := afero.Exists(fs, "/proj/uppercase.wasm")
+	if err != nil || !ok {
+		t.Errorf("expected /proj/uppercase.wasm to have been written, exists=%v err=%v", ok, err)
+	}
+
+	loaded, err := project.LoadCfg(afero.NewBasePathFs(fs, "/proj"))
+	if err != nil {
+		t.Fatalf("project.LoadCfg on installed project: %v", err)
+	}
+	if loaded.Language != project.WasmLangTinygo {
+		t.Errorf("loaded Language = %q, want %q", loaded.Language, project.WasmLangTinygo)
+	}
+}
+
+func TestInstallChecksumMismatch(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	writeTestGallery(t, fs, []byte("fake-wasm-bytes"))
+	// Corrupt the artifact after the manifest's checksum was computed.
+	if err := afero.WriteFile(fs, "/mod.wasm", []byte("tampered"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Install(fs, "/gallery.yaml", "uppercase", "/proj", InstallOptions{}); err == nil {
+		t.Error("Install should have failed on a checksum mismatch")
+	}
+}
+
+func TestParseRef(t *testing.T) {
+	source, name, err := ParseRef("internal/uppercase")
+	if err != nil {
+		t.Fatalf("ParseRef: %v", err)
+	}
+	if source != "internal" || name != "uppercase" {
+		t.Errorf("ParseRef = (%q, %q), want (\"internal\", \"uppercase\")", source, name)
+	}
+
+	if _, _, err := ParseRef("no-slash"); err == nil {
+		t.Error("ParseRef(\"no-slash\") should have failed")
+	}
+}
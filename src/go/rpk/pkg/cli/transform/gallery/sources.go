@@ -0,0 +1,29 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package gallery
+
+// DefaultSource is the URL of the default gallery index. It is not applied
+// automatically: ParseRef rejects a bare "<name>" with no "<gallery>/"
+// prefix.
+const DefaultSource = "https://gallery.redpanda.com/transforms.yaml"
+
+// Sources maps a short gallery alias (as used in "<alias>/<name>"
+// references) to the URL or path its index should be loaded from. Operators
+// can add entries here to point at internally hosted galleries.
+type Sources map[string]string
+
+// Resolve looks up alias in s, falling back to treating alias itself as a
+// source (a raw URL or local path) when it isn't a configured alias.
+func (s Sources) Resolve(alias string) string {
+	if src, ok := s[alias]; ok {
+		return src
+	}
+	return alias
+}
@@ -0,0 +1,93 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package project
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/afero"
+)
+
+// Builder is the set of operations needed to scaffold, compile and clean up
+// a transform written in a particular WasmLang. Each supported language
+// implements Builder so that the rest of the transform subsystem (project
+// scaffolding, `rpk transform build`, CI templates) can stay language
+// agnostic.
+type Builder interface {
+	// Detect checks that the toolchain required to build this language is
+	// installed and on the PATH, returning an actionable error with install
+	// instructions if it is not.
+	Detect() error
+	// Init scaffolds a new project for this language into fs at dir.
+	Init(fs afero.Fs, dir string, c Config) error
+	// Build compiles the transform project rooted at dir, returning the
+	// path to the produced `.wasm` binary.
+	Build(ctx context.Context, dir string, c Config) (wasmPath string, err error)
+	// Clean removes build artifacts produced by Build.
+	Clean(dir string) error
+}
+
+// builders holds the registered Builder for every supported WasmLang.
+var builders = map[WasmLang]Builder{
+	WasmLangTinygo:         tinygoBuilder{},
+	WasmLangRust:           rustBuilder{},
+	WasmLangAssemblyScript: assemblyScriptBuilder{},
+	WasmLangZig:            zigBuilder{},
+}
+
+// BuilderFor returns the Builder registered for lang, or an error if lang
+// isn't one of AllWasmLangs.
+func BuilderFor(lang WasmLang) (Builder, error) {
+	b, ok := builders[lang]
+	if !ok {
+		return nil, fmt.Errorf("unknown language: %q", lang)
+	}
+	return b, nil
+}
+
+// RequireToolchain resolves the Builder for lang and confirms its toolchain
+// is installed, returning an actionable error otherwise. Callers that only
+// need to read a transform.yaml (deploy, topic inspection, CI linting,
+// gallery-installed projects) should not call this - it belongs on the
+// build path, e.g. `rpk transform build`, immediately before Builder.Build.
+func RequireToolchain(lang WasmLang) error {
+	builder, err := BuilderFor(lang)
+	if err != nil {
+		return err
+	}
+	if err := builder.Detect(); err != nil {
+		return fmt.Errorf("building a %q transform requires its toolchain: %w", lang, err)
+	}
+	return nil
+}
+
+// missingToolchain builds a consistent, actionable error for a Detect
+// failure, pointing the user at how to install the missing binary.
+func missingToolchain(bin, installHint string) error {
+	return fmt.Errorf("%q was not found on your PATH; %s", bin, installHint)
+}
+
+// removeIfExists deletes every file matching the glob pattern, ignoring
+// the case where none exist.
+func removeIfExists(pattern string) error {
+	matches, err := filepath.Glob(pattern)
+	if err != nil {
+		return err
+	}
+	for _, m := range matches {
+		if err := os.RemoveAll(m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
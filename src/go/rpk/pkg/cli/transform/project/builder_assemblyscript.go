@@ -0,0 +1,52 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package project
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/spf13/afero"
+)
+
+// assemblyScriptBuilder builds transforms written in AssemblyScript,
+// compiled via the `asc` compiler.
+type assemblyScriptBuilder struct{}
+
+func (assemblyScriptBuilder) Detect() error {
+	if _, err := exec.LookPath("asc"); err != nil {
+		return missingToolchain("asc", "install it with `npm install -g assemblyscript`")
+	}
+	return nil
+}
+
+func (assemblyScriptBuilder) Init(fs afero.Fs, dir string, c Config) error {
+	if err := fs.MkdirAll(filepath.Join(dir, "assembly"), 0o755); err != nil {
+		return err
+	}
+	pkgJSON := fmt.Sprintf("{\n  \"name\": %q,\n  \"scripts\": {\n    \"asbuild\": \"asc assembly/index.ts -o build/%s.wasm\"\n  }\n}\n", c.Name, c.Name)
+	return afero.WriteFile(fs, filepath.Join(dir, "package.json"), []byte(pkgJSON), 0o644)
+}
+
+func (assemblyScriptBuilder) Build(ctx context.Context, dir string, c Config) (string, error) {
+	out := filepath.Join(dir, "build", c.Name+".wasm")
+	cmd := exec.CommandContext(ctx, "asc", "assembly/index.ts", "-o", out, "--optimize")
+	cmd.Dir = dir
+	if b, err := cmd.CombinedOutput(); err != nil {
+		return "", fmt.Errorf("asc build failed: %w\n%s", err, b)
+	}
+	return out, nil
+}
+
+func (assemblyScriptBuilder) Clean(dir string) error {
+	return removeIfExists(filepath.Join(dir, "build", "*.wasm"))
+}
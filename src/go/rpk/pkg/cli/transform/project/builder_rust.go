@@ -0,0 +1,62 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package project
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+const rustWasmTarget = "wasm32-wasi"
+
+// rustBuilder builds transforms written in Rust, compiled via cargo to the
+// wasm32-wasi target.
+type rustBuilder struct{}
+
+func (rustBuilder) Detect() error {
+	if _, err := exec.LookPath("cargo"); err != nil {
+		return missingToolchain("cargo", "install Rust via https://rustup.rs")
+	}
+	out, err := exec.Command("rustup", "target", "list", "--installed").CombinedOutput()
+	if err == nil && !strings.Contains(string(out), rustWasmTarget) {
+		return fmt.Errorf("the %q target is not installed; run `rustup target add %s`", rustWasmTarget, rustWasmTarget)
+	}
+	return nil
+}
+
+func (rustBuilder) Init(fs afero.Fs, dir string, c Config) error {
+	if err := fs.MkdirAll(filepath.Join(dir, "src"), 0o755); err != nil {
+		return err
+	}
+	cargoToml := fmt.Sprintf("[package]\nname = %q\nversion = \"0.1.0\"\nedition = \"2021\"\n\n[lib]\ncrate-type = [\"cdylib\"]\n", c.Name)
+	return afero.WriteFile(fs, filepath.Join(dir, "Cargo.toml"), []byte(cargoToml), 0o644)
+}
+
+func (rustBuilder) Build(ctx context.Context, dir string, c Config) (string, error) {
+	cmd := exec.CommandContext(ctx, "cargo", "build", "--release", "--target", rustWasmTarget)
+	cmd.Dir = dir
+	if b, err := cmd.CombinedOutput(); err != nil {
+		return "", fmt.Errorf("cargo build failed: %w\n%s", err, b)
+	}
+	// cargo names a library artifact after the crate, with "-" turned into "_".
+	return filepath.Join(dir, "target", rustWasmTarget, "release", strings.ReplaceAll(c.Name, "-", "_")+".wasm"), nil
+}
+
+func (rustBuilder) Clean(dir string) error {
+	cmd := exec.Command("cargo", "clean")
+	cmd.Dir = dir
+	_, err := cmd.CombinedOutput()
+	return err
+}
@@ -0,0 +1,29 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package project
+
+import "testing"
+
+func TestBuilderFor(t *testing.T) {
+	for _, lang := range AllWasmLangs {
+		if _, err := BuilderFor(WasmLang(lang)); err != nil {
+			t.Errorf("BuilderFor(%q): %v", lang, err)
+		}
+	}
+	if _, err := BuilderFor("cobol"); err == nil {
+		t.Error("BuilderFor(\"cobol\") should have failed for an unregistered language")
+	}
+}
+
+func TestRequireToolchainUnknownLang(t *testing.T) {
+	if err := RequireToolchain("cobol"); err == nil {
+		t.Error("RequireToolchain(\"cobol\") should have failed for an unregistered language")
+	}
+}
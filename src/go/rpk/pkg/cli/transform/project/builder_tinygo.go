@@ -0,0 +1,51 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package project
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/spf13/afero"
+)
+
+// tinygoBuilder builds transforms written in Go using TinyGo's wasi target.
+type tinygoBuilder struct{}
+
+func (tinygoBuilder) Detect() error {
+	if _, err := exec.LookPath("tinygo"); err != nil {
+		return missingToolchain("tinygo", "install it from https://tinygo.org/getting-started/install/")
+	}
+	return nil
+}
+
+func (tinygoBuilder) Init(fs afero.Fs, dir string, c Config) error {
+	if err := fs.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	goMod := fmt.Sprintf("module %s\n\ngo 1.20\n", c.Name)
+	return afero.WriteFile(fs, filepath.Join(dir, "go.mod"), []byte(goMod), 0o644)
+}
+
+func (tinygoBuilder) Build(ctx context.Context, dir string, c Config) (string, error) {
+	out := filepath.Join(dir, c.Name+".wasm")
+	cmd := exec.CommandContext(ctx, "tinygo", "build", "-o", out, "-target=wasi", ".")
+	cmd.Dir = dir
+	if b, err := cmd.CombinedOutput(); err != nil {
+		return "", fmt.Errorf("tinygo build failed: %w\n%s", err, b)
+	}
+	return out, nil
+}
+
+func (tinygoBuilder) Clean(dir string) error {
+	return removeIfExists(filepath.Join(dir, "*.wasm"))
+}
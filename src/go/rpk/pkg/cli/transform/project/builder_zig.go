@@ -0,0 +1,51 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package project
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/spf13/afero"
+)
+
+// zigBuilder builds transforms written in Zig, compiled to wasi via the
+// zig build system.
+type zigBuilder struct{}
+
+func (zigBuilder) Detect() error {
+	if _, err := exec.LookPath("zig"); err != nil {
+		return missingToolchain("zig", "install it from https://ziglang.org/download/")
+	}
+	return nil
+}
+
+func (zigBuilder) Init(fs afero.Fs, dir string, c Config) error {
+	if err := fs.MkdirAll(filepath.Join(dir, "src"), 0o755); err != nil {
+		return err
+	}
+	buildZig := fmt.Sprintf("// build.zig for %s\n", c.Name)
+	return afero.WriteFile(fs, filepath.Join(dir, "build.zig"), []byte(buildZig), 0o644)
+}
+
+func (zigBuilder) Build(ctx context.Context, dir string, c Config) (string, error) {
+	cmd := exec.CommandContext(ctx, "zig", "build", "-Dtarget=wasm32-wasi", "-Doptimize=ReleaseSmall")
+	cmd.Dir = dir
+	if b, err := cmd.CombinedOutput(); err != nil {
+		return "", fmt.Errorf("zig build failed: %w\n%s", err, b)
+	}
+	return filepath.Join(dir, "zig-out", "bin", c.Name+".wasm"), nil
+}
+
+func (zigBuilder) Clean(dir string) error {
+	return removeIfExists(filepath.Join(dir, "zig-out"))
+}
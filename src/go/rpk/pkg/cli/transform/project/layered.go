@@ -0,0 +1,148 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package project
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/afero"
+	"gopkg.in/yaml.v3"
+)
+
+// TransformDDir is the directory, alongside transform.yaml, that holds
+// additional overlay files applied in lexical order.
+var TransformDDir = "transform.d"
+
+// loadLayered reads transform.yaml, merges in its `include:`d files and any
+// transform.d/*.yaml overlays, applies the requested profile, and returns
+// the flattened result as a generic document ready to be re-marshaled and
+// decoded into a Config.
+func loadLayered(fs afero.Fs, opts LoadOptions) (map[string]interface{}, error) {
+	base, err := loadYAMLMap(fs, ConfigFileName)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pattern := range asStringSlice(base["include"]) {
+		matches, err := afero.Glob(fs, pattern)
+		if err != nil {
+			return nil, fmt.Errorf("include %q: %w", pattern, err)
+		}
+		sort.Strings(matches)
+		for _, m := range matches {
+			layer, err := loadYAMLMap(fs, m)
+			if err != nil {
+				return nil, err
+			}
+			base = deepMerge(base, layer)
+		}
+	}
+
+	overlays, err := listTransformDFiles(fs)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range overlays {
+		layer, err := loadYAMLMap(fs, f)
+		if err != nil {
+			return nil, err
+		}
+		base = deepMerge(base, layer)
+	}
+
+	profiles, _ := base["profiles"].(map[string]interface{})
+	if opts.Profile != "" {
+		layer, ok := profiles[opts.Profile].(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("no profile named %q", opts.Profile)
+		}
+		base = deepMerge(base, layer)
+	}
+
+	delete(base, "include")
+	delete(base, "profiles")
+	return base, nil
+}
+
+// listTransformDFiles returns the *.yaml/*.yml files directly under
+// TransformDDir in lexical order, or nil if the directory doesn't exist.
+func listTransformDFiles(fs afero.Fs) ([]string, error) {
+	entries, err := afero.ReadDir(fs, TransformDDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var files []string
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		if strings.HasSuffix(e.Name(), ".yaml") || strings.HasSuffix(e.Name(), ".yml") {
+			files = append(files, TransformDDir+"/"+e.Name())
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// loadYAMLMap reads and parses the yaml file at path on fs into a generic
+// document.
+func loadYAMLMap(fs afero.Fs, path string) (map[string]interface{}, error) {
+	b, err := afero.ReadFile(fs, path)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err := yaml.Unmarshal(b, &m); err != nil {
+		return nil, fmt.Errorf("parsing %q: %w", path, err)
+	}
+	return m, nil
+}
+
+// deepMerge returns a new document with src layered over dst: nested maps
+// are merged key by key, and any other value (scalars, lists - which
+// includes the `env` map's values once merged) in src overrides dst.
+func deepMerge(dst, src map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(dst)+len(src))
+	for k, v := range dst {
+		out[k] = v
+	}
+	for k, v := range src {
+		if dstMap, ok := out[k].(map[string]interface{}); ok {
+			if srcMap, ok := v.(map[string]interface{}); ok {
+				out[k] = deepMerge(dstMap, srcMap)
+				continue
+			}
+		}
+		out[k] = v
+	}
+	return out
+}
+
+// asStringSlice coerces a generically-decoded yaml value into a []string,
+// ignoring any non-string elements.
+func asStringSlice(v interface{}) []string {
+	items, ok := v.([]interface{})
+	if !ok {
+		return nil
+	}
+	out := make([]string, 0, len(items))
+	for _, item := range items {
+		if s, ok := item.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
@@ -0,0 +1,109 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package project
+
+import (
+	"testing"
+
+	"github.com/spf13/afero"
+)
+
+func TestDeepMerge(t *testing.T) {
+	dst := map[string]interface{}{
+		"name": "base",
+		"env": map[string]interface{}{
+			"A": "1",
+			"B": "2",
+		},
+	}
+	src := map[string]interface{}{
+		"name": "overridden",
+		"env": map[string]interface{}{
+			"B": "overridden",
+			"C": "3",
+		},
+	}
+	merged := deepMerge(dst, src)
+
+	if merged["name"] != "overridden" {
+		t.Errorf("name = %v, want scalar overridden by src", merged["name"])
+	}
+	env, ok := merged["env"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("env is not a map: %v", merged["env"])
+	}
+	want := map[string]interface{}{"A": "1", "B": "overridden", "C": "3"}
+	for k, v := range want {
+		if env[k] != v {
+			t.Errorf("env[%q] = %v, want %v", k, env[k], v)
+		}
+	}
+}
+
+func TestLoadCfgOptsMergesTransformD(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	writeFile(t, fs, "transform.yaml", `
+name: my-transform
+input-topic: in
+output-topic: out
+language: tinygo
+env:
+  A: base
+`)
+	writeFile(t, fs, "transform.d/01-overrides.yaml", `
+output-topic: out-overridden
+env:
+  A: overridden
+  B: added
+`)
+
+	c, err := LoadCfgOpts(fs, LoadOptions{})
+	if err != nil {
+		t.Fatalf("LoadCfgOpts: %v", err)
+	}
+	if c.OutputTopic != "out-overridden" {
+		t.Errorf("OutputTopic = %q, want %q", c.OutputTopic, "out-overridden")
+	}
+	if c.Env["A"] != "overridden" || c.Env["B"] != "added" {
+		t.Errorf("Env = %v, want A=overridden, B=added", c.Env)
+	}
+}
+
+func TestLoadCfgOptsAppliesProfile(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	writeFile(t, fs, "transform.yaml", `
+name: my-transform
+input-topic: in
+output-topic: out
+language: tinygo
+profiles:
+  staging:
+    output-topic: out-staging
+`)
+
+	c, err := LoadCfgOpts(fs, LoadOptions{Profile: "staging"})
+	if err != nil {
+		t.Fatalf("LoadCfgOpts: %v", err)
+	}
+	if c.OutputTopic != "out-staging" {
+		t.Errorf("OutputTopic = %q, want %q", c.OutputTopic, "out-staging")
+	}
+
+	if _, err := LoadCfgOpts(fs, LoadOptions{Profile: "nonexistent"}); err == nil {
+		t.Error("expected an error for a profile that isn't declared")
+	}
+}
+
+func writeFile(t *testing.T, fs afero.Fs, path, contents string) {
+	t.Helper()
+	if err := afero.WriteFile(fs, path, []byte(contents), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
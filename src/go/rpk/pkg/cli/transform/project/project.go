@@ -10,6 +10,7 @@
 package project
 
 import (
+	"bytes"
 	"fmt"
 	"strings"
 
@@ -20,10 +21,18 @@ import (
 type WasmLang string
 
 const (
-	WasmLangTinygo WasmLang = "tinygo"
+	WasmLangTinygo         WasmLang = "tinygo"
+	WasmLangRust           WasmLang = "rust"
+	WasmLangAssemblyScript WasmLang = "assemblyscript"
+	WasmLangZig            WasmLang = "zig"
 )
 
-var AllWasmLangs = []string{"tinygo"}
+var AllWasmLangs = []string{
+	string(WasmLangTinygo),
+	string(WasmLangRust),
+	string(WasmLangAssemblyScript),
+	string(WasmLangZig),
+}
 
 func (l *WasmLang) Set(str string) error {
 	lower := strings.ToLower(str)
@@ -45,12 +54,19 @@ func (WasmLang) Type() string {
 }
 
 type Config struct {
-	Name        string            `yaml:"name"`
-	Description string            `yaml:"description,omitempty"`
-	InputTopic  string            `yaml:"input-topic"`
-	OutputTopic string            `yaml:"output-topic"`
-	Language    WasmLang          `yaml:"language"`
-	Env         map[string]string `yaml:"env,omitempty"`
+	Name        string `yaml:"name"`
+	Description string `yaml:"description,omitempty"`
+	InputTopic  string `yaml:"input-topic,omitempty"`
+	OutputTopic string `yaml:"output-topic,omitempty"`
+	// Inputs and Outputs are the multi-topic successors to InputTopic and
+	// OutputTopic. A config sets one shape or the other; see
+	// EffectiveInputs/EffectiveOutputs for a unified read-time view that
+	// doesn't get re-serialized into both.
+	Inputs   []TopicRef        `yaml:"inputs,omitempty"`
+	Outputs  []TopicRef        `yaml:"outputs,omitempty"`
+	Routes   []Route           `yaml:"routes,omitempty"`
+	Language WasmLang          `yaml:"language"`
+	Env      map[string]string `yaml:"env,omitempty"`
 }
 
 var ConfigFileName = "transform.yaml"
@@ -59,15 +75,53 @@ func MarshalConfig(c Config) ([]byte, error) {
 	return yaml.Marshal(c)
 }
 
+// UnmarshalConfig decodes b into c using a strict decoder: unknown fields
+// (e.g. a typo like `inputTopic` instead of `input-topic`) are rejected
+// rather than silently ignored.
 func UnmarshalConfig(b []byte, c *Config) error {
-	return yaml.Unmarshal(b, c)
+	dec := yaml.NewDecoder(bytes.NewReader(b))
+	dec.KnownFields(true)
+	return dec.Decode(c)
 }
 
+// LoadCfg loads and validates the transform.yaml in fs's working directory,
+// merged with its `include:`d files and transform.d/ overlays, with no
+// profile applied. See LoadCfgOpts to apply a named profile.
 func LoadCfg(fs afero.Fs) (c Config, err error) {
-	b, err := afero.ReadFile(fs, ConfigFileName)
+	return LoadCfgOpts(fs, LoadOptions{})
+}
+
+// LoadOptions controls how LoadCfgOpts assembles the effective Config from
+// the base transform.yaml and its overlays.
+type LoadOptions struct {
+	// Profile, if set, merges the named section of the top-level
+	// `profiles:` map over the base config, e.g. "staging".
+	Profile string
+}
+
+// LoadCfgOpts loads transform.yaml, deep-merges it with any `transform.d/*.yaml`
+// overlays and `include:`d files, applies opts.Profile if set, and validates
+// the resulting Config. The line/column positions on returned
+// ValidationErrors are those of the merged document as re-marshaled, not of
+// transform.yaml or any overlay file.
+func LoadCfgOpts(fs afero.Fs, opts LoadOptions) (c Config, err error) {
+	merged, err := loadLayered(fs, opts)
 	if err != nil {
 		return c, err
 	}
-	err = UnmarshalConfig(b, &c)
-	return c, err
+	b, err := yaml.Marshal(merged)
+	if err != nil {
+		return c, err
+	}
+	if err = UnmarshalConfig(b, &c); err != nil {
+		return c, err
+	}
+	var node yaml.Node
+	if err := yaml.Unmarshal(b, &node); err != nil {
+		return c, err
+	}
+	if errs := c.Validate(&node); len(errs) > 0 {
+		return c, errs
+	}
+	return c, nil
 }
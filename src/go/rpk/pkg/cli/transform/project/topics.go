@@ -0,0 +1,60 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package project
+
+// TopicRef names a topic a transform reads from or writes to, optionally
+// narrowed to a subset of its partitions or keys.
+type TopicRef struct {
+	Name string `yaml:"name"`
+	// PartitionFilter restricts processing to a subset of partitions, e.g.
+	// "0-3" or "even". An empty filter means all partitions.
+	PartitionFilter string `yaml:"partition-filter,omitempty"`
+	// KeyPattern restricts processing to records whose key matches the
+	// given glob. An empty pattern means all keys.
+	KeyPattern string `yaml:"key-pattern,omitempty"`
+}
+
+// Route directs records read from Input to Output when Predicate matches,
+// letting a single Wasm module fan-in from several input topics and
+// fan-out to different output topics.
+type Route struct {
+	Input string `yaml:"input"`
+	// Predicate is evaluated against each record; an empty predicate
+	// matches every record read from Input.
+	Predicate string `yaml:"predicate,omitempty"`
+	Output    string `yaml:"output"`
+}
+
+// EffectiveInputs returns c.Inputs if set, or c.InputTopic as a single
+// TopicRef otherwise. This is a read-time convenience for code (Validate,
+// deploy, routing) that wants a unified view of whichever shape the config
+// was written in; it does not mutate or get stored on Config, so
+// MarshalConfig only ever re-emits the fields that were actually set.
+func (c Config) EffectiveInputs() []TopicRef {
+	if len(c.Inputs) > 0 {
+		return c.Inputs
+	}
+	if c.InputTopic != "" {
+		return []TopicRef{{Name: c.InputTopic}}
+	}
+	return nil
+}
+
+// EffectiveOutputs returns c.Outputs if set, or c.OutputTopic as a single
+// TopicRef otherwise. See EffectiveInputs.
+func (c Config) EffectiveOutputs() []TopicRef {
+	if len(c.Outputs) > 0 {
+		return c.Outputs
+	}
+	if c.OutputTopic != "" {
+		return []TopicRef{{Name: c.OutputTopic}}
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package project
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/afero"
+)
+
+func TestEffectiveInputsOutputsFromLegacy(t *testing.T) {
+	var c Config
+	if err := UnmarshalConfig([]byte(`
+name: my-transform
+input-topic: in
+output-topic: out
+language: tinygo
+`), &c); err != nil {
+		t.Fatal(err)
+	}
+	if got := c.EffectiveInputs(); len(got) != 1 || got[0].Name != "in" {
+		t.Errorf("EffectiveInputs() = %v, want a single {Name: \"in\"}", got)
+	}
+	if got := c.EffectiveOutputs(); len(got) != 1 || got[0].Name != "out" {
+		t.Errorf("EffectiveOutputs() = %v, want a single {Name: \"out\"}", got)
+	}
+	// The legacy fields are the only ones actually set; EffectiveInputs is a
+	// read-time view and must not mutate Inputs/Outputs.
+	if c.Inputs != nil {
+		t.Errorf("Inputs = %v, want nil (legacy config should not be cross-populated)", c.Inputs)
+	}
+	if c.Outputs != nil {
+		t.Errorf("Outputs = %v, want nil (legacy config should not be cross-populated)", c.Outputs)
+	}
+}
+
+func TestEffectiveInputsOutputsFromMultiTopic(t *testing.T) {
+	var c Config
+	if err := UnmarshalConfig([]byte(`
+name: my-transform
+inputs:
+  - name: in-a
+  - name: in-b
+outputs:
+  - name: out-a
+language: tinygo
+`), &c); err != nil {
+		t.Fatal(err)
+	}
+	if got := c.EffectiveInputs(); len(got) != 2 || got[0].Name != "in-a" || got[1].Name != "in-b" {
+		t.Errorf("EffectiveInputs() = %v, want [{Name: in-a} {Name: in-b}]", got)
+	}
+	if got := c.EffectiveOutputs(); len(got) != 1 || got[0].Name != "out-a" {
+		t.Errorf("EffectiveOutputs() = %v, want a single {Name: \"out-a\"}", got)
+	}
+	// The multi-topic fields are the only ones actually set; EffectiveInputs
+	// is a read-time view and must not mutate InputTopic/OutputTopic.
+	if c.InputTopic != "" {
+		t.Errorf("InputTopic = %q, want empty (multi-topic config should not be aliased)", c.InputTopic)
+	}
+	if c.OutputTopic != "" {
+		t.Errorf("OutputTopic = %q, want empty (multi-topic config should not be aliased)", c.OutputTopic)
+	}
+}
+
+func TestMarshalConfigRoundTripsLegacyShapeOnly(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	writeFile(t, fs, "transform.yaml", `
+name: my-transform
+input-topic: in
+output-topic: out
+language: tinygo
+`)
+	c, err := LoadCfg(fs)
+	if err != nil {
+		t.Fatalf("LoadCfg: %v", err)
+	}
+	b, err := MarshalConfig(c)
+	if err != nil {
+		t.Fatalf("MarshalConfig: %v", err)
+	}
+	got := string(b)
+	if !strings.Contains(got, "input-topic: in") || !strings.Contains(got, "output-topic: out") {
+		t.Errorf("marshaled config missing legacy fields:\n%s", got)
+	}
+	if strings.Contains(got, "inputs:") || strings.Contains(got, "outputs:") {
+		t.Errorf("marshaled config should not synthesize inputs:/outputs: for a legacy-only config:\n%s", got)
+	}
+}
+
+func TestMarshalConfigRoundTripsMultiTopicShapeOnly(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	writeFile(t, fs, "transform.yaml", `
+name: my-transform
+inputs:
+  - name: in-a
+outputs:
+  - name: out-a
+language: tinygo
+`)
+	c, err := LoadCfg(fs)
+	if err != nil {
+		t.Fatalf("LoadCfg: %v", err)
+	}
+	b, err := MarshalConfig(c)
+	if err != nil {
+		t.Fatalf("MarshalConfig: %v", err)
+	}
+	got := string(b)
+	if !strings.Contains(got, "inputs:") || !strings.Contains(got, "outputs:") {
+		t.Errorf("marshaled config missing multi-topic fields:\n%s", got)
+	}
+	if strings.Contains(got, "input-topic:") || strings.Contains(got, "output-topic:") {
+		t.Errorf("marshaled config should not synthesize input-topic:/output-topic: for a multi-topic-only config:\n%s", got)
+	}
+}
+
+func TestValidateCatchesBadInputsOutputsAndRoutes(t *testing.T) {
+	c := Config{
+		Name:     "my-transform",
+		Language: WasmLangTinygo,
+		Inputs:   []TopicRef{{Name: "bad topic!"}},
+		Outputs:  []TopicRef{{Name: "out"}},
+		Routes: []Route{
+			{Input: "in", Output: "undeclared-output"},
+		},
+	}
+	errs := c.Validate(nil)
+
+	var gotInputErr, gotRouteErr bool
+	for _, e := range errs {
+		if e.Field == "inputs[0].name" {
+			gotInputErr = true
+		}
+		if e.Field == "routes[0].output" {
+			gotRouteErr = true
+		}
+	}
+	if !gotInputErr {
+		t.Errorf("expected an error for the malformed inputs[0].name, got: %v", errs)
+	}
+	if !gotRouteErr {
+		t.Errorf("expected an error for the route to an undeclared output, got: %v", errs)
+	}
+}
+
+func TestValidateAllowsRouteToDeclaredOutput(t *testing.T) {
+	c := Config{
+		Name:     "my-transform",
+		Language: WasmLangTinygo,
+		Inputs:   []TopicRef{{Name: "in"}},
+		Outputs:  []TopicRef{{Name: "out"}},
+		Routes: []Route{
+			{Input: "in", Output: "out"},
+		},
+	}
+	errs := c.Validate(nil)
+	for _, e := range errs {
+		if e.Field == "routes[0].output" {
+			t.Errorf("unexpected error for a route to a declared output: %v", e)
+		}
+	}
+}
+
+func TestValidateCatchesRouteToUndeclaredInput(t *testing.T) {
+	c := Config{
+		Name:     "my-transform",
+		Language: WasmLangTinygo,
+		Inputs:   []TopicRef{{Name: "in"}},
+		Outputs:  []TopicRef{{Name: "out"}},
+		Routes: []Route{
+			{Input: "undeclared-input", Output: "out"},
+		},
+	}
+	errs := c.Validate(nil)
+
+	var gotRouteErr bool
+	for _, e := range errs {
+		if e.Field == "routes[0].input" {
+			gotRouteErr = true
+		}
+	}
+	if !gotRouteErr {
+		t.Errorf("expected an error for the route from an undeclared input, got: %v", errs)
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package project
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// topicNameRe matches the set of characters Kafka allows in a topic name.
+var topicNameRe = regexp.MustCompile(`^[a-zA-Z0-9._-]{1,249}$`)
+
+// envKeyRe matches a valid environment variable name.
+var envKeyRe = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// ValidationError is a single problem found in a transform.yaml, annotated
+// with the source line/column it came from so `rpk` can print it with
+// context.
+type ValidationError struct {
+	Field   string
+	Message string
+	Line    int
+	Column  int
+}
+
+func (e ValidationError) Error() string {
+	if e.Line == 0 {
+		return fmt.Sprintf("%s: %s", e.Field, e.Message)
+	}
+	return fmt.Sprintf("%s:%d:%d: %s", e.Field, e.Line, e.Column, e.Message)
+}
+
+// ValidationErrors is every problem found while validating a Config. It
+// implements error so it can be returned and printed directly, but callers
+// that want to report each problem individually (e.g. with source context)
+// can range over it.
+type ValidationErrors []ValidationError
+
+func (errs ValidationErrors) Error() string {
+	msgs := make([]string, len(errs))
+	for i, e := range errs {
+		msgs[i] = e.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Validate checks c for missing required fields and malformed values,
+// returning one ValidationError per problem found. node is the parsed yaml
+// document c was decoded from, used to recover line/column information for
+// each field; it may be nil, in which case errors are reported without
+// source positions.
+func (c Config) Validate(node *yaml.Node) ValidationErrors {
+	var errs ValidationErrors
+
+	line, col := fieldPos(node, "name")
+	if c.Name == "" {
+		errs = append(errs, ValidationError{Field: "name", Message: "is required", Line: line, Column: col})
+	}
+
+	// input-topic/output-topic and inputs/outputs are alternative ways of
+	// declaring the same thing; a config is only missing an input (output)
+	// if neither shape was used. See EffectiveInputs/EffectiveOutputs.
+	line, col = fieldPos(node, "input-topic")
+	if len(c.EffectiveInputs()) == 0 {
+		errs = append(errs, ValidationError{Field: "input-topic", Message: "is required", Line: line, Column: col})
+	} else if len(c.Inputs) == 0 && !topicNameRe.MatchString(c.InputTopic) {
+		errs = append(errs, ValidationError{Field: "input-topic", Message: fmt.Sprintf("%q is not a valid Kafka topic name", c.InputTopic), Line: line, Column: col})
+	}
+
+	line, col = fieldPos(node, "output-topic")
+	if len(c.EffectiveOutputs()) == 0 {
+		errs = append(errs, ValidationError{Field: "output-topic", Message: "is required", Line: line, Column: col})
+	} else if len(c.Outputs) == 0 && !topicNameRe.MatchString(c.OutputTopic) {
+		errs = append(errs, ValidationError{Field: "output-topic", Message: fmt.Sprintf("%q is not a valid Kafka topic name", c.OutputTopic), Line: line, Column: col})
+	}
+
+	line, col = fieldPos(node, "language")
+	if c.Language == "" {
+		errs = append(errs, ValidationError{Field: "language", Message: "is required", Line: line, Column: col})
+	} else if _, err := BuilderFor(c.Language); err != nil {
+		errs = append(errs, ValidationError{Field: "language", Message: err.Error(), Line: line, Column: col})
+	}
+
+	envLine, envCol := fieldPos(node, "env")
+	for k := range c.Env {
+		if !envKeyRe.MatchString(k) {
+			errs = append(errs, ValidationError{Field: "env." + k, Message: fmt.Sprintf("%q is not a valid environment variable name", k), Line: envLine, Column: envCol})
+		}
+	}
+
+	inputsLine, inputsCol := fieldPos(node, "inputs")
+	for i, in := range c.Inputs {
+		errs = append(errs, validateTopicRef(fmt.Sprintf("inputs[%d]", i), in, inputsLine, inputsCol)...)
+	}
+
+	outputsLine, outputsCol := fieldPos(node, "outputs")
+	for i, out := range c.Outputs {
+		errs = append(errs, validateTopicRef(fmt.Sprintf("outputs[%d]", i), out, outputsLine, outputsCol)...)
+	}
+
+	// inputNames and outputNames are built from the effective (legacy or
+	// multi-topic) shape so routes are checked the same way regardless of
+	// which shape declared them.
+	inputNames := make(map[string]bool, len(c.EffectiveInputs()))
+	for _, in := range c.EffectiveInputs() {
+		if in.Name != "" {
+			inputNames[in.Name] = true
+		}
+	}
+	outputNames := make(map[string]bool, len(c.EffectiveOutputs()))
+	for _, out := range c.EffectiveOutputs() {
+		if out.Name != "" {
+			outputNames[out.Name] = true
+		}
+	}
+
+	routesLine, routesCol := fieldPos(node, "routes")
+	for i, r := range c.Routes {
+		field := fmt.Sprintf("routes[%d]", i)
+		if r.Input == "" {
+			errs = append(errs, ValidationError{Field: field + ".input", Message: "is required", Line: routesLine, Column: routesCol})
+		} else if !topicNameRe.MatchString(r.Input) {
+			errs = append(errs, ValidationError{Field: field + ".input", Message: fmt.Sprintf("%q is not a valid Kafka topic name", r.Input), Line: routesLine, Column: routesCol})
+		} else if !inputNames[r.Input] {
+			errs = append(errs, ValidationError{Field: field + ".input", Message: fmt.Sprintf("references undeclared input %q", r.Input), Line: routesLine, Column: routesCol})
+		}
+		if r.Output == "" {
+			errs = append(errs, ValidationError{Field: field + ".output", Message: "is required", Line: routesLine, Column: routesCol})
+		} else if !outputNames[r.Output] {
+			errs = append(errs, ValidationError{Field: field + ".output", Message: fmt.Sprintf("references undeclared output %q", r.Output), Line: routesLine, Column: routesCol})
+		}
+	}
+
+	return errs
+}
+
+// validateTopicRef checks that ref's name is present and a valid Kafka
+// topic name, returning one ValidationError per problem found under field.
+func validateTopicRef(field string, ref TopicRef, line, col int) ValidationErrors {
+	var errs ValidationErrors
+	if ref.Name == "" {
+		errs = append(errs, ValidationError{Field: field + ".name", Message: "is required", Line: line, Column: col})
+	} else if !topicNameRe.MatchString(ref.Name) {
+		errs = append(errs, ValidationError{Field: field + ".name", Message: fmt.Sprintf("%q is not a valid Kafka topic name", ref.Name), Line: line, Column: col})
+	}
+	return errs
+}
+
+// fieldPos returns the line and column of field's key within the top-level
+// mapping node. It returns 0, 0 if node is nil, isn't a mapping, or doesn't
+// contain field.
+func fieldPos(node *yaml.Node, field string) (line, col int) {
+	if node == nil {
+		return 0, 0
+	}
+	doc := node
+	if doc.Kind == yaml.DocumentNode && len(doc.Content) > 0 {
+		doc = doc.Content[0]
+	}
+	if doc.Kind != yaml.MappingNode {
+		return 0, 0
+	}
+	for i := 0; i+1 < len(doc.Content); i += 2 {
+		key := doc.Content[i]
+		if key.Value == field {
+			return key.Line, key.Column
+		}
+	}
+	return 0, 0
+}
@@ -0,0 +1,75 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package project
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestUnmarshalConfigRejectsUnknownFields(t *testing.T) {
+	var c Config
+	err := UnmarshalConfig([]byte(`
+name: my-transform
+inputTopic: in
+output-topic: out
+language: tinygo
+`), &c)
+	if err == nil {
+		t.Fatal("expected an error for the unknown field \"inputTopic\"")
+	}
+}
+
+func TestValidateReportsMissingFields(t *testing.T) {
+	src := []byte(`
+name: ""
+language: tinygo
+`)
+	var node yaml.Node
+	if err := yaml.Unmarshal(src, &node); err != nil {
+		t.Fatal(err)
+	}
+	var c Config
+	if err := UnmarshalConfig(src, &c); err != nil {
+		t.Fatal(err)
+	}
+
+	errs := c.Validate(&node)
+	wantFields := []string{"name", "input-topic", "output-topic"}
+	for _, field := range wantFields {
+		found := false
+		for _, e := range errs {
+			if e.Field == field {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("Validate() did not report a missing %q, got: %v", field, errs)
+		}
+	}
+}
+
+func TestValidateRejectsBadTopicName(t *testing.T) {
+	c := Config{
+		Name:        "my-transform",
+		InputTopic:  "not a valid topic!",
+		OutputTopic: "out",
+		Language:    WasmLangTinygo,
+	}
+	errs := c.Validate(nil)
+	if len(errs) == 0 {
+		t.Fatal("expected an error for the malformed input-topic")
+	}
+	if !strings.Contains(errs.Error(), "input-topic") {
+		t.Errorf("errors didn't mention input-topic: %v", errs)
+	}
+}
@@ -0,0 +1,24 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package template
+
+import (
+	"embed"
+	"io/fs"
+)
+
+//go:embed builtin
+var builtinFS embed.FS
+
+// Builtin returns the fs.FS rooted at rpk's built-in project templates:
+// tinygo-passthrough, tinygo-json-filter, rust-regex.
+func Builtin() (fs.FS, error) {
+	return fs.Sub(builtinFS, "builtin")
+}
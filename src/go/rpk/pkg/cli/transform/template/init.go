@@ -0,0 +1,65 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package template
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/redpanda-data/redpanda/src/go/rpk/pkg/cli/transform/project"
+	"github.com/spf13/afero"
+)
+
+// Init renders the template named name from source (see Resolve) into
+// destDir on fs, answering its questions with answers, and writes a
+// transform.yaml built from the "name", "input-topic" and "output-topic"
+// answers every builtin template asks for.
+func Init(fs afero.Fs, source, name, destDir string, answers map[string]string) (project.Config, error) {
+	root, cleanup, err := Resolve(source)
+	if err != nil {
+		return project.Config{}, err
+	}
+	defer cleanup()
+
+	if err := Render(root, name, fs, destDir, answers); err != nil {
+		return project.Config{}, err
+	}
+
+	cfg := project.Config{
+		Name:        answers["name"],
+		InputTopic:  answers["input-topic"],
+		OutputTopic: answers["output-topic"],
+		Language:    languageFor(name),
+	}
+	if cfg.Name == "" {
+		return project.Config{}, fmt.Errorf("template %q requires a %q answer", name, "name")
+	}
+
+	b, err := project.MarshalConfig(cfg)
+	if err != nil {
+		return project.Config{}, err
+	}
+	if err := afero.WriteFile(fs, filepath.Join(destDir, project.ConfigFileName), b, 0o644); err != nil {
+		return project.Config{}, err
+	}
+	return cfg, nil
+}
+
+// languageFor infers a template's WasmLang from its name prefix, e.g.
+// "tinygo-passthrough" -> tinygo, "rust-regex" -> rust.
+func languageFor(name string) project.WasmLang {
+	for _, lang := range project.AllWasmLangs {
+		if strings.HasPrefix(name, lang+"-") {
+			return project.WasmLang(lang)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,76 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package template
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/spf13/afero"
+)
+
+// Render walks the template named name at the root of root and writes it
+// into destDir on dest: every ".tmpl" file is rendered through text/template
+// with answers as its data (so "{{.name}}" pulls from answers["name"], and a
+// hyphenated key is read with "{{index . "some-key"}}") and written without
+// the ".tmpl" suffix; every other file is copied
+// verbatim. questions.yaml itself is skipped.
+func Render(root fs.FS, name string, dest afero.Fs, destDir string, answers map[string]string) error {
+	return fs.WalkDir(root, name, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		if d.Name() == QuestionsFileName {
+			return nil
+		}
+
+		rel, err := filepath.Rel(name, p)
+		if err != nil {
+			return err
+		}
+		b, err := fs.ReadFile(root, p)
+		if err != nil {
+			return err
+		}
+
+		outRel := strings.TrimSuffix(rel, ".tmpl")
+		content := b
+		if strings.HasSuffix(p, ".tmpl") {
+			if content, err = renderFile(rel, b, answers); err != nil {
+				return err
+			}
+		}
+
+		outPath := filepath.Join(destDir, outRel)
+		if err := dest.MkdirAll(filepath.Dir(outPath), 0o755); err != nil {
+			return err
+		}
+		return afero.WriteFile(dest, outPath, content, 0o644)
+	})
+}
+
+func renderFile(name string, src []byte, answers map[string]string) ([]byte, error) {
+	tmpl, err := template.New(name).Parse(string(src))
+	if err != nil {
+		return nil, fmt.Errorf("parsing template %q: %w", name, err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, answers); err != nil {
+		return nil, fmt.Errorf("rendering template %q: %w", name, err)
+	}
+	return buf.Bytes(), nil
+}
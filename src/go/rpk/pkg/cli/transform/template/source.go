@@ -0,0 +1,60 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package template
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// Resolve returns the fs.FS templates should be read from for source, which
+// may be empty (rpk's builtin templates), a local directory, or a remote
+// https:// git repository URL. The returned cleanup func must be called
+// once the caller is done reading from the result.
+func Resolve(source string) (root fs.FS, cleanup func(), err error) {
+	switch {
+	case source == "":
+		root, err = Builtin()
+		return root, func() {}, err
+	case isRemote(source):
+		return cloneRemote(source)
+	default:
+		return os.DirFS(source), func() {}, nil
+	}
+}
+
+// isRemote reports whether source looks like a remote git repository URL
+// rather than a local path. Only https:// is accepted: git:// is
+// unauthenticated plaintext, and anything else risks being misread by `git
+// clone` as a local path or, worse, a flag.
+func isRemote(source string) bool {
+	return strings.HasPrefix(source, "https://")
+}
+
+// cloneRemote shallow-clones source into a temporary directory and returns
+// an fs.FS rooted there, along with a cleanup func that removes it.
+func cloneRemote(source string) (fs.FS, func(), error) {
+	dir, err := os.MkdirTemp("", "rpk-transform-template-*")
+	if err != nil {
+		return nil, nil, err
+	}
+	// The "--" stops git from interpreting a source crafted to look like a
+	// flag (e.g. "--upload-pack=...") as anything other than a positional
+	// repository argument.
+	cmd := exec.Command("git", "clone", "--depth=1", "--", source, dir)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		os.RemoveAll(dir)
+		return nil, nil, fmt.Errorf("cloning template source %q: %w\n%s", source, err, out)
+	}
+	return os.DirFS(dir), func() { os.RemoveAll(dir) }, nil
+}
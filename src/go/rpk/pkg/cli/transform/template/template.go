@@ -0,0 +1,71 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+// Package template generates new transform projects from named scaffolds
+// (e.g. "tinygo-passthrough") resolved from rpk's built-in templates or a
+// remote git/https location.
+package template
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+
+	"gopkg.in/yaml.v3"
+)
+
+// QuestionsFileName is the prompt spec every template directory must
+// contain, one level below its source root.
+const QuestionsFileName = "questions.yaml"
+
+// Question is a single prompt a template asks the user when scaffolding a
+// new project, e.g. the transform's name or its input topic.
+type Question struct {
+	Key     string `yaml:"key"`
+	Prompt  string `yaml:"prompt"`
+	Default string `yaml:"default,omitempty"`
+}
+
+// QuestionSpec is the parsed contents of a template's questions.yaml.
+type QuestionSpec struct {
+	Questions []Question `yaml:"questions"`
+}
+
+// LoadQuestions reads and parses the questions.yaml for the template named
+// name at the root of root.
+func LoadQuestions(root fs.FS, name string) (QuestionSpec, error) {
+	var qs QuestionSpec
+	b, err := fs.ReadFile(root, path.Join(name, QuestionsFileName))
+	if err != nil {
+		return qs, fmt.Errorf("loading questions for template %q: %w", name, err)
+	}
+	if err := yaml.Unmarshal(b, &qs); err != nil {
+		return qs, fmt.Errorf("parsing questions for template %q: %w", name, err)
+	}
+	return qs, nil
+}
+
+// List returns the name of every template available at the root of root: a
+// template is any top-level directory containing a questions.yaml.
+func List(root fs.FS) ([]string, error) {
+	entries, err := fs.ReadDir(root, ".")
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		if _, err := fs.Stat(root, path.Join(e.Name(), QuestionsFileName)); err == nil {
+			names = append(names, e.Name())
+		}
+	}
+	return names, nil
+}
@@ -0,0 +1,104 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package template
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/afero"
+)
+
+func TestListBuiltin(t *testing.T) {
+	root, err := Builtin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	names, err := List(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]bool{"tinygo-passthrough": true, "tinygo-json-filter": true, "rust-regex": true}
+	for _, n := range names {
+		delete(want, n)
+	}
+	if len(want) != 0 {
+		t.Errorf("List() missing templates: %v", want)
+	}
+}
+
+func TestRenderEveryBuiltinTemplate(t *testing.T) {
+	root, err := Builtin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	names, err := List(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	answers := map[string]string{
+		"name":         "my-transform",
+		"input-topic":  "in",
+		"output-topic": "out",
+		"filter-field": "status",
+		"filter-value": "ok",
+		"pattern":      "^ok$",
+	}
+	for _, name := range names {
+		dest := afero.NewMemMapFs()
+		if err := Render(root, name, dest, "/out", answers); err != nil {
+			t.Errorf("Render(%q): %v", name, err)
+		}
+	}
+}
+
+func TestRenderSubstitutesHyphenatedKeys(t *testing.T) {
+	root, err := Builtin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dest := afero.NewMemMapFs()
+	answers := map[string]string{
+		"name":         "my-transform",
+		"input-topic":  "in-topic",
+		"output-topic": "out-topic",
+		"filter-field": "status",
+		"filter-value": "ok",
+	}
+	if err := Render(root, "tinygo-json-filter", dest, "/out", answers); err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	b, err := afero.ReadFile(dest, "/out/main.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := string(b)
+	for _, want := range []string{"in-topic", "out-topic", `doc["status"]`, `== "ok"`} {
+		if !strings.Contains(got, want) {
+			t.Errorf("rendered main.go missing %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestIsRemote(t *testing.T) {
+	cases := map[string]bool{
+		"https://example.com/org/templates.git": true,
+		"git://example.com/org/templates.git":   false,
+		"http://example.com/org/templates.git":  false,
+		"./local/templates":                     false,
+		"--upload-pack=evil":                    false,
+	}
+	for source, want := range cases {
+		if got := isRemote(source); got != want {
+			t.Errorf("isRemote(%q) = %v, want %v", source, got, want)
+		}
+	}
+}